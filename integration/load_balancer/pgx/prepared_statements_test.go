@@ -9,6 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// TestPrepared exercises the 150-statement/100-pool workload but does not
+// cover tiberiuv/pgdog#chunk0-5 (bounded cache, re-preparation, metrics):
+// none of that exists yet. See ../../PENDING_SUBSYSTEMS.md for tracking.
 func TestPrepared(t *testing.T) {
 	done := make(chan int)
 	concurrency := 100